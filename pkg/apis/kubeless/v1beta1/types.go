@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2016-2019 Bitnami
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/autoscaling/v2beta1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FunctionSpec contains the spec of the function
+type FunctionSpec struct {
+	Handler             string `json:"handler"`
+	Function            string `json:"function"`
+	FunctionContentType string `json:"function-content-type,omitempty"`
+	Checksum            string `json:"checksum,omitempty"`
+	Runtime             string `json:"runtime"`
+	Timeout             string `json:"timeout,omitempty"`
+	Deps                string `json:"deps,omitempty"`
+
+	Deployment                  appsv1.Deployment                   `json:"deployment,omitempty"`
+	ServiceSpec                 v1.ServiceSpec                      `json:"service,omitempty"`
+	HorizontalPodAutoscalerSpec v2beta1.HorizontalPodAutoscalerSpec `json:"horizontalPodAutoscaler,omitempty"`
+}
+
+// FunctionStatus contains the observed status of the function
+type FunctionStatus struct {
+	// Conditions is the set of conditions observed on the Function.
+	Conditions []FunctionCondition `json:"conditions,omitempty"`
+}
+
+// FunctionConditionType describes the aspect of function state that a
+// FunctionCondition is reporting on.
+type FunctionConditionType string
+
+// FunctionReady reports whether a Function's Deployment, Service and
+// HorizontalPodAutoscaler (when applicable) are all ready to serve traffic.
+const FunctionReady FunctionConditionType = "Ready"
+
+// FunctionCondition describes a single point-in-time observation of a
+// Function's state, keyed by Type so callers can look up the latest
+// value without scanning for duplicates.
+type FunctionCondition struct {
+	Type               FunctionConditionType `json:"type"`
+	Status             v1.ConditionStatus    `json:"status"`
+	LastTransitionTime metav1.Time           `json:"lastTransitionTime,omitempty"`
+	Reason             string                `json:"reason,omitempty"`
+	Message            string                `json:"message,omitempty"`
+}
+
+// Function describes a Kubeless function
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type Function struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FunctionSpec   `json:"spec"`
+	Status FunctionStatus `json:"status,omitempty"`
+}
+
+// FunctionList is a list of Functions
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type FunctionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Function `json:"items"`
+}
+
+// FunctionTemplateSpec is a parameterized Function. Template holds the
+// FunctionSpec marshalled to JSON with {{ .Values.X }} placeholders, and
+// ValuesSchema declares the shape a FunctionInstance's Values map must have
+// before the template can be rendered into a concrete FunctionSpec.
+type FunctionTemplateSpec struct {
+	Template     string                 `json:"template"`
+	ValuesSchema map[string]ValueSchema `json:"valuesSchema,omitempty"`
+}
+
+// ValueSchema describes one entry a FunctionInstance's Values map must
+// satisfy: the JSON type the value must have, and whether it must be set at
+// all.
+type ValueSchema struct {
+	Type     string `json:"type"`
+	Required bool   `json:"required,omitempty"`
+}
+
+// FunctionTemplate describes a reusable, parameterized Function that
+// FunctionInstances render into concrete Functions.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type FunctionTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec FunctionTemplateSpec `json:"spec"`
+}
+
+// FunctionTemplateList is a list of FunctionTemplates
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type FunctionTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []FunctionTemplate `json:"items"`
+}
+
+// FunctionInstanceSpec references the FunctionTemplate a FunctionInstance
+// materializes, along with the Values to render it with.
+type FunctionInstanceSpec struct {
+	TemplateName string                 `json:"templateName"`
+	Values       map[string]interface{} `json:"values,omitempty"`
+}
+
+// FunctionInstanceStatus reports the Function a FunctionInstance last
+// materialized.
+type FunctionInstanceStatus struct {
+	FunctionName string              `json:"functionName,omitempty"`
+	Conditions   []FunctionCondition `json:"conditions,omitempty"`
+}
+
+// FunctionInstance materializes a FunctionTemplate, plus a set of Values,
+// into a concrete Function that FunctionController reconciles like any
+// other.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type FunctionInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FunctionInstanceSpec   `json:"spec"`
+	Status FunctionInstanceStatus `json:"status,omitempty"`
+}
+
+// FunctionInstanceList is a list of FunctionInstances
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type FunctionInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []FunctionInstance `json:"items"`
+}
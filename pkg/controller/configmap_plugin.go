@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2016-2019 Bitnami
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	kubelessApi "github.com/kubeless/kubeless/pkg/apis/kubeless/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// configMapPlugin manages the ConfigMap holding a Function's source and
+// handler. It does not add the ConfigMap as a volume to the Deployment.
+type configMapPlugin struct {
+	c *FunctionController
+}
+
+func newConfigMapPlugin(c *FunctionController) ResourcePlugin {
+	return &configMapPlugin{c: c}
+}
+
+func (p *configMapPlugin) Name() string {
+	return "configmap"
+}
+
+func (p *configMapPlugin) Create(ctx context.Context, funcObj *kubelessApi.Function) error {
+	cm := p.c.getConfigMapSpec(funcObj)
+	cm.OwnerReferences = p.OwnerRefs(funcObj)
+	_, err := p.c.clientset.CoreV1().ConfigMaps(funcObj.Namespace).Create(cm)
+	return err
+}
+
+func (p *configMapPlugin) Update(ctx context.Context, funcObj *kubelessApi.Function) error {
+	if _, err := p.c.clientset.CoreV1().ConfigMaps(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{}); err != nil {
+		return err
+	}
+	cm := p.c.getConfigMapSpec(funcObj)
+	cm.OwnerReferences = p.OwnerRefs(funcObj)
+	_, err := p.c.clientset.CoreV1().ConfigMaps(funcObj.Namespace).Update(cm)
+	return err
+}
+
+func (p *configMapPlugin) Delete(ctx context.Context, namespace, name string) error {
+	return p.c.clientset.CoreV1().ConfigMaps(namespace).Delete(name, &metav1.DeleteOptions{})
+}
+
+func (p *configMapPlugin) OwnerRefs(funcObj *kubelessApi.Function) []metav1.OwnerReference {
+	return []metav1.OwnerReference{functionOwnerRef(funcObj)}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2016-2019 Bitnami
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	kubelessApi "github.com/kubeless/kubeless/pkg/apis/kubeless/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// deploymentPlugin manages the Deployment that runs a Function.
+type deploymentPlugin struct {
+	c *FunctionController
+}
+
+func newDeploymentPlugin(c *FunctionController) ResourcePlugin {
+	return &deploymentPlugin{c: c}
+}
+
+func (p *deploymentPlugin) Name() string {
+	return "deployment"
+}
+
+func (p *deploymentPlugin) Create(ctx context.Context, funcObj *kubelessApi.Function) error {
+	dpm, err := p.c.getDeploymentSpec(funcObj)
+	if err != nil {
+		return err
+	}
+	dpm.OwnerReferences = p.OwnerRefs(funcObj)
+	_, err = p.c.clientset.AppsV1().Deployments(funcObj.Namespace).Create(dpm)
+	return err
+}
+
+func (p *deploymentPlugin) Update(ctx context.Context, funcObj *kubelessApi.Function) error {
+	if _, err := p.c.clientset.AppsV1().Deployments(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{}); err != nil {
+		return err
+	}
+	dpm, err := p.c.getDeploymentSpec(funcObj)
+	if err != nil {
+		return err
+	}
+	dpm.OwnerReferences = p.OwnerRefs(funcObj)
+	_, err = p.c.clientset.AppsV1().Deployments(funcObj.Namespace).Update(dpm)
+	return err
+}
+
+func (p *deploymentPlugin) Delete(ctx context.Context, namespace, name string) error {
+	return p.c.clientset.AppsV1().Deployments(namespace).Delete(name, &metav1.DeleteOptions{})
+}
+
+func (p *deploymentPlugin) OwnerRefs(funcObj *kubelessApi.Function) []metav1.OwnerReference {
+	return []metav1.OwnerReference{functionOwnerRef(funcObj)}
+}
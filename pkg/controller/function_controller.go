@@ -0,0 +1,238 @@
+/*
+Copyright (c) 2016-2019 Bitnami
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package controller reconciles kubeless Function custom resources onto the
+// Kubernetes resources (Deployment, Service, ConfigMap, HorizontalPodAutoscaler,
+// ...) that actually run them.
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/imdario/mergo"
+	kubelessApi "github.com/kubeless/kubeless/pkg/apis/kubeless/v1beta1"
+	kubelessclientset "github.com/kubeless/kubeless/pkg/client/clientset/versioned"
+	"github.com/kubeless/kubeless/pkg/langruntime"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/autoscaling/v2beta1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	kubelessConfigMapName = "kubeless-config"
+	functionKind          = "Function"
+)
+
+// FunctionController reconciles kubeless Function objects onto the
+// Kubernetes resources that back them. Its resource-specific logic is split
+// across the ResourcePlugin implementations registered with
+// RegisterResourcePlugin; see resource_plugin.go.
+type FunctionController struct {
+	logger            *logrus.Entry
+	clientset         kubernetes.Interface
+	kubelessclientset kubelessclientset.Interface
+	langRuntime       *langruntime.Langruntimes
+	config            *v1.ConfigMap
+}
+
+// NewFunctionController returns a FunctionController wired to the given
+// clientsets, loading its kubeless-config ConfigMap and runtime defaults
+// from the given namespace. kubelessclientset is used to patch a Function's
+// status (see WaitForFunctionReady) and may be nil for callers that only
+// need ensureK8sResources/deleteK8sResources.
+func NewFunctionController(clientset kubernetes.Interface, kubelessclientset kubelessclientset.Interface, namespace string) (*FunctionController, error) {
+	config, err := clientset.CoreV1().ConfigMaps(namespace).Get(kubelessConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the %s ConfigMap: %v", kubelessConfigMapName, err)
+	}
+
+	lr := langruntime.New(config)
+	lr.ReadConfigMap()
+
+	return &FunctionController{
+		logger:            logrus.WithField("pkg", "controller"),
+		clientset:         clientset,
+		kubelessclientset: kubelessclientset,
+		langRuntime:       lr,
+		config:            config,
+	}, nil
+}
+
+// ensureK8sResources creates or updates every Kubernetes resource that backs
+// funcObj by running it through each registered ResourcePlugin, in
+// registration order. Errors from individual plugins don't stop the others
+// from running; they are collected and returned together so a caller can see
+// the full picture of what failed. There is no rollback of resources a
+// preceding plugin already created or updated: the controller relies on the
+// normal reconcile loop calling ensureK8sResources again (the same way it
+// would recover from a partial apply caused by anything else) to finish the
+// job, rather than compensating here.
+func (c *FunctionController) ensureK8sResources(funcObj *kubelessApi.Function) error {
+	ctx := context.Background()
+	var errs []error
+	for _, plugin := range c.resourcePlugins() {
+		if err := c.ensureWithPlugin(ctx, plugin, funcObj); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", plugin.Name(), err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// ensureWithPlugin runs the update-or-create dance for a single plugin:
+// Update first (the common case once a Function has been reconciled once),
+// falling back to Create when the resource doesn't exist yet.
+func (c *FunctionController) ensureWithPlugin(ctx context.Context, plugin ResourcePlugin, funcObj *kubelessApi.Function) error {
+	err := plugin.Update(ctx, funcObj)
+	if k8sErrIsNotFound(err) {
+		err = plugin.Create(ctx, funcObj)
+	}
+	return err
+}
+
+// deleteK8sResources deletes every Kubernetes resource that backs the
+// Function named name in namespace, running plugins in the reverse of the
+// order ensureK8sResources uses them in, so dependents are removed before
+// the resources they depend on. A plugin finding nothing to delete is not an
+// error; all plugins are attempted regardless of earlier failures, and any
+// real failures are returned together.
+func (c *FunctionController) deleteK8sResources(namespace, name string) error {
+	ctx := context.Background()
+	plugins := c.resourcePlugins()
+	var errs []error
+	for i := len(plugins) - 1; i >= 0; i-- {
+		plugin := plugins[i]
+		if err := plugin.Delete(ctx, namespace, name); err != nil && !k8sErrIsNotFound(err) {
+			errs = append(errs, fmt.Errorf("%s: %v", plugin.Name(), err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// functionOwnerRef returns the OwnerReference every built-in plugin stamps
+// onto the resources it manages, so deleting a Function garbage-collects
+// them even if deleteK8sResources is never called directly.
+func functionOwnerRef(funcObj *kubelessApi.Function) metav1.OwnerReference {
+	controller := true
+	blockOwnerDeletion := true
+	return metav1.OwnerReference{
+		APIVersion:         kubelessApi.SchemeGroupVersion.String(),
+		Kind:               functionKind,
+		Name:               funcObj.Name,
+		UID:                funcObj.UID,
+		Controller:         &controller,
+		BlockOwnerDeletion: &blockOwnerDeletion,
+	}
+}
+
+// getDeploymentSpec builds the Deployment that should run funcObj, layering
+// the "deployment"/"deployment-template" key of the kubeless-config
+// ConfigMap (defaults) under funcObj.Spec.Deployment (overrides) and filling
+// in the runtime's default probes where the Function didn't set its own.
+func (c *FunctionController) getDeploymentSpec(funcObj *kubelessApi.Function) (*appsv1.Deployment, error) {
+	ri, err := c.langRuntime.GetRuntimeInfo(funcObj.Spec.Runtime)
+	if err != nil {
+		return nil, err
+	}
+
+	dpm := funcObj.Spec.Deployment.DeepCopy()
+	dpm.ObjectMeta.Name = funcObj.Name
+	dpm.ObjectMeta.Namespace = funcObj.Namespace
+	dpm.OwnerReferences = []metav1.OwnerReference{functionOwnerRef(funcObj)}
+
+	cmDeployment, key, err := c.resolveDeploymentOverride(funcObj, ri)
+	if err != nil {
+		return nil, err
+	}
+	if cmDeployment != "" {
+		var base appsv1.Deployment
+		decoder := json.NewDecoder(strings.NewReader(cmDeployment))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&base); err != nil {
+			return nil, fmt.Errorf("unable to parse the %q key of the %s ConfigMap: %v", key, kubelessConfigMapName, err)
+		}
+		if err := mergo.Merge(&base, dpm, mergo.WithOverride); err != nil {
+			return nil, fmt.Errorf("unable to merge the %q key of the %s ConfigMap with the Function's deployment: %v", key, kubelessConfigMapName, err)
+		}
+		dpm = &base
+	}
+
+	// Deployment-level annotations are also meaningful on the Pods it
+	// creates (e.g. a sidecar injector keyed off an annotation set via the
+	// "deployment" ConfigMap override), so propagate them down, without
+	// overwriting annotations the Function already set at the Pod level.
+	if len(dpm.Annotations) > 0 {
+		if dpm.Spec.Template.Annotations == nil {
+			dpm.Spec.Template.Annotations = map[string]string{}
+		}
+		for k, v := range dpm.Annotations {
+			if _, exists := dpm.Spec.Template.Annotations[k]; !exists {
+				dpm.Spec.Template.Annotations[k] = v
+			}
+		}
+	}
+
+	if len(dpm.Spec.Template.Spec.Containers) == 0 {
+		dpm.Spec.Template.Spec.Containers = []v1.Container{{}}
+	}
+	c.applyRuntimeDefaults(ri, &dpm.Spec.Template.Spec.Containers[0])
+
+	return dpm, nil
+}
+
+func (c *FunctionController) getServiceSpec(funcObj *kubelessApi.Function) *v1.Service {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            funcObj.Name,
+			Namespace:       funcObj.Namespace,
+			Labels:          funcObj.Labels,
+			OwnerReferences: []metav1.OwnerReference{functionOwnerRef(funcObj)},
+		},
+		Spec: funcObj.Spec.ServiceSpec,
+	}
+	return svc
+}
+
+func (c *FunctionController) getHPASpec(funcObj *kubelessApi.Function) *v2beta1.HorizontalPodAutoscaler {
+	return &v2beta1.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            funcObj.Name,
+			Namespace:       funcObj.Namespace,
+			OwnerReferences: []metav1.OwnerReference{functionOwnerRef(funcObj)},
+		},
+		Spec: funcObj.Spec.HorizontalPodAutoscalerSpec,
+	}
+}
+
+func (c *FunctionController) getConfigMapSpec(funcObj *kubelessApi.Function) *v1.ConfigMap {
+	return &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            funcObj.Name,
+			Namespace:       funcObj.Namespace,
+			OwnerReferences: []metav1.OwnerReference{functionOwnerRef(funcObj)},
+		},
+		Data: map[string]string{
+			"handler":    funcObj.Spec.Handler,
+			funcObj.Name: funcObj.Spec.Function,
+		},
+	}
+}
@@ -1,17 +1,25 @@
 package controller
 
 import (
+	"context"
+	"fmt"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/ghodss/yaml"
 	kubelessApi "github.com/kubeless/kubeless/pkg/apis/kubeless/v1beta1"
+	kubelessfake "github.com/kubeless/kubeless/pkg/client/clientset/versioned/fake"
 	"github.com/kubeless/kubeless/pkg/langruntime"
 	"github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/api/autoscaling/v2beta1"
 	v1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	ktesting "k8s.io/client-go/testing"
@@ -189,6 +197,43 @@ func TestEnsureK8sResourcesWithDeploymentDefinitionFromConfigMapUnknownKey(t *te
 	}
 }
 
+func TestEnsureK8sResourcesWithDeploymentTemplate(t *testing.T) {
+	funcObj := testFunc()
+	deploymentTemplateData := `{
+		"metadata": {
+			"annotations": {
+				"templated-name": "{{ .Function.Name }}"
+			}
+		}
+	}`
+
+	clientset := fake.NewSimpleClientset()
+	controller := testController(clientset, funcObj.Namespace, map[string]string{
+		"deployment-template": deploymentTemplateData,
+		"runtime-images":      testRuntimeImages(),
+	})
+
+	if err := controller.ensureK8sResources(funcObj); err != nil {
+		t.Fatalf("Creating/Updating resources returned err: %v", err)
+	}
+	dpm, _ := clientset.AppsV1().Deployments(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{})
+	if got := dpm.ObjectMeta.Annotations["templated-name"]; got != "foo" {
+		t.Fatalf("Expecting the 'templated-name' annotation to be expanded to 'foo' but received %q", got)
+	}
+}
+
+func TestEnsureK8sResourcesWithInvalidDeploymentTemplate(t *testing.T) {
+	funcObj := testFunc()
+	controller := testController(fake.NewSimpleClientset(), funcObj.Namespace, map[string]string{
+		"deployment":     `{{ .Function.DoesNotExist.Foo }}`,
+		"runtime-images": testRuntimeImages(),
+	})
+
+	if err := controller.ensureK8sResources(funcObj); err == nil {
+		t.Fatalf("Expected a template execution error but ensureK8sResources succeeded")
+	}
+}
+
 func TestEnsureK8sResourcesWithLivenessProbeFromConfigMap(t *testing.T) {
 	funcObj := testFunc()
 	runtimeImages := `[
@@ -243,6 +288,392 @@ func TestEnsureK8sResourcesWithLivenessProbeFromConfigMap(t *testing.T) {
 
 }
 
+func TestEnsureK8sResourcesNetworkPolicyDisabledByDefault(t *testing.T) {
+	funcObj := testFunc()
+	clientset := fake.NewSimpleClientset()
+	controller := testController(clientset, funcObj.Namespace, map[string]string{
+		"runtime-images": testRuntimeImages(),
+	})
+
+	if err := controller.ensureK8sResources(funcObj); err != nil {
+		t.Fatalf("Creating/Updating resources returned err: %v", err)
+	}
+	if _, err := clientset.NetworkingV1().NetworkPolicies(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{}); !k8sErrors.IsNotFound(err) {
+		t.Fatalf("expected no NetworkPolicy without network-policy: enabled, got err: %v", err)
+	}
+}
+
+func TestEnsureK8sResourcesNetworkPolicyRestrictsToContainerPorts(t *testing.T) {
+	funcObj := testFunc()
+	funcObj.Spec.Deployment.Spec.Template.Spec.Containers[0].Ports = []v1.ContainerPort{
+		{ContainerPort: 8080},
+	}
+
+	clientset := fake.NewSimpleClientset()
+	controller := testController(clientset, funcObj.Namespace, map[string]string{
+		"runtime-images": testRuntimeImages(),
+		"network-policy": "enabled",
+	})
+
+	if err := controller.ensureK8sResources(funcObj); err != nil {
+		t.Fatalf("Creating/Updating resources returned err: %v", err)
+	}
+	np, err := clientset.NetworkingV1().NetworkPolicies(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected a NetworkPolicy to be created, got err: %v", err)
+	}
+	if len(np.Spec.Ingress) != 1 || len(np.Spec.Ingress[0].Ports) != 1 {
+		t.Fatalf("expected a single ingress rule restricted to the container's port, got %+v", np.Spec.Ingress)
+	}
+	port := np.Spec.Ingress[0].Ports[0]
+	if port.Port == nil || port.Port.IntValue() != 8080 || port.Protocol == nil || *port.Protocol != v1.ProtocolTCP {
+		t.Fatalf("expected ingress port 8080/TCP, got %+v", port)
+	}
+}
+
+func TestEnsureK8sResourcesNetworkPolicyNoPortsDeniesIngress(t *testing.T) {
+	funcObj := testFunc()
+	clientset := fake.NewSimpleClientset()
+	controller := testController(clientset, funcObj.Namespace, map[string]string{
+		"runtime-images": testRuntimeImages(),
+		"network-policy": "enabled",
+	})
+
+	if err := controller.ensureK8sResources(funcObj); err != nil {
+		t.Fatalf("Creating/Updating resources returned err: %v", err)
+	}
+	np, err := clientset.NetworkingV1().NetworkPolicies(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected a NetworkPolicy to be created, got err: %v", err)
+	}
+	if len(np.Spec.Ingress) != 0 {
+		t.Fatalf("expected no ingress rules (deny-all) for a container with no declared ports, got %+v", np.Spec.Ingress)
+	}
+}
+
+func TestEnsureK8sResourcesNetworkPolicySelectorUsesMergedLabels(t *testing.T) {
+	funcObj := testFunc()
+	deploymentConfigData := `{
+		"spec": {
+			"template": {
+				"metadata": {
+					"labels": {
+						"app": "from-deploy-cm"
+					}
+				}
+			}
+		}
+	}`
+
+	clientset := fake.NewSimpleClientset()
+	controller := testController(clientset, funcObj.Namespace, map[string]string{
+		"deployment":     deploymentConfigData,
+		"runtime-images": testRuntimeImages(),
+		"network-policy": "enabled",
+	})
+
+	if err := controller.ensureK8sResources(funcObj); err != nil {
+		t.Fatalf("Creating/Updating resources returned err: %v", err)
+	}
+	dpm, err := clientset.AppsV1().Deployments(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected a Deployment to be created, got err: %v", err)
+	}
+	np, err := clientset.NetworkingV1().NetworkPolicies(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected a NetworkPolicy to be created, got err: %v", err)
+	}
+	if !reflect.DeepEqual(np.Spec.PodSelector.MatchLabels, dpm.Spec.Template.Labels) {
+		t.Fatalf("expected the NetworkPolicy's selector to match the Deployment's actual pod template labels %v, got %v", dpm.Spec.Template.Labels, np.Spec.PodSelector.MatchLabels)
+	}
+	if np.Spec.PodSelector.MatchLabels["app"] != "from-deploy-cm" {
+		t.Fatalf("expected the selector to pick up the ConfigMap-provided label the Function itself left unset, got %v", np.Spec.PodSelector.MatchLabels)
+	}
+}
+
+// mockPlugin records the order in which its Create/Delete was invoked and,
+// if fail is set, returns an error identifying itself so TestResourcePlugin*
+// can assert both ordering and multi-error aggregation.
+type mockPlugin struct {
+	name  string
+	fail  bool
+	order *[]string
+	mu    *sync.Mutex
+}
+
+func (m *mockPlugin) Name() string { return m.name }
+
+func (m *mockPlugin) record() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	*m.order = append(*m.order, m.name)
+}
+
+func (m *mockPlugin) Create(ctx context.Context, funcObj *kubelessApi.Function) error {
+	m.record()
+	if m.fail {
+		return fmt.Errorf("induced failure in %s", m.name)
+	}
+	return nil
+}
+
+func (m *mockPlugin) Update(ctx context.Context, funcObj *kubelessApi.Function) error {
+	// Always report NotFound so ensureK8sResources falls back to Create,
+	// the same path a Function being reconciled for the first time takes.
+	return k8sErrors.NewNotFound(schema.GroupResource{}, funcObj.Name)
+}
+
+func (m *mockPlugin) Delete(ctx context.Context, namespace, name string) error {
+	m.record()
+	if m.fail {
+		return fmt.Errorf("induced failure in %s", m.name)
+	}
+	return nil
+}
+
+func (m *mockPlugin) OwnerRefs(funcObj *kubelessApi.Function) []metav1.OwnerReference {
+	return nil
+}
+
+func TestResourcePluginOrderingAndPartialFailure(t *testing.T) {
+	origFactories := resourcePluginFactories
+	defer func() { resourcePluginFactories = origFactories }()
+
+	var order []string
+	var mu sync.Mutex
+	mock := func(name string, fail bool) ResourcePluginFactory {
+		return func(c *FunctionController) ResourcePlugin {
+			return &mockPlugin{name: name, fail: fail, order: &order, mu: &mu}
+		}
+	}
+	resourcePluginFactories = []ResourcePluginFactory{
+		mock("first", false),
+		mock("second", true),
+		mock("third", false),
+	}
+
+	controller := testController(fake.NewSimpleClientset(), "default", map[string]string{})
+	funcObj := testFunc()
+
+	err := controller.ensureK8sResources(funcObj)
+	if err == nil {
+		t.Fatalf("expected an aggregated error from the failing plugin")
+	}
+	if !strings.Contains(err.Error(), "induced failure in second") {
+		t.Errorf("expected aggregated error to mention the failing plugin, got: %v", err)
+	}
+	if !reflect.DeepEqual(order, []string{"first", "second", "third"}) {
+		t.Errorf("expected every plugin to run in registration order despite the failure, got %v", order)
+	}
+
+	order = nil
+	err = controller.deleteK8sResources("default", funcObj.Name)
+	if err == nil {
+		t.Fatalf("expected an aggregated error from the failing plugin")
+	}
+	if !reflect.DeepEqual(order, []string{"third", "second", "first"}) {
+		t.Errorf("expected delete to run plugins in reverse registration order, got %v", order)
+	}
+}
+
+func TestWaitForFunctionReady(t *testing.T) {
+	funcObj := testFunc()
+	var replicas int32 = 1
+
+	dpm := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: funcObj.Name, Namespace: funcObj.Namespace, Generation: 1},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 1},
+	}
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: funcObj.Name, Namespace: funcObj.Namespace},
+		Spec:       v1.ServiceSpec{ClusterIP: "10.0.0.1"},
+	}
+	endpoints := &v1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Name: funcObj.Name, Namespace: funcObj.Namespace},
+		Subsets: []v1.EndpointSubset{
+			{Addresses: []v1.EndpointAddress{{IP: "10.0.0.2"}}},
+		},
+	}
+
+	clientset := fake.NewSimpleClientset(dpm, svc, endpoints)
+	controller := testController(clientset, funcObj.Namespace, map[string]string{
+		"runtime-images": testRuntimeImages(),
+	})
+	// patchReadyCondition patches an existing Function, it doesn't create
+	// one, so the fake kubelessclientset needs funcObj seeded up front.
+	controller.kubelessclientset = kubelessfake.NewSimpleClientset(funcObj)
+
+	// Flip the deployment to ready partway through the poll loop, the same
+	// way a real rollout would only become Available a beat after it's
+	// created.
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		updated := dpm.DeepCopy()
+		updated.Status.UpdatedReplicas = 1
+		updated.Status.AvailableReplicas = 1
+		clientset.AppsV1().Deployments(funcObj.Namespace).UpdateStatus(updated)
+	}()
+
+	if err := controller.WaitForFunctionReady(funcObj, 2*time.Second); err != nil {
+		t.Fatalf("expected the deployment to become ready before the timeout, got: %v", err)
+	}
+}
+
+func TestWaitForFunctionReadyTimeout(t *testing.T) {
+	funcObj := testFunc()
+	var replicas int32 = 1
+
+	dpm := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: funcObj.Name, Namespace: funcObj.Namespace, Generation: 1},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 1},
+	}
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: funcObj.Name, Namespace: funcObj.Namespace},
+		Spec:       v1.ServiceSpec{ClusterIP: v1.ClusterIPNone},
+	}
+
+	clientset := fake.NewSimpleClientset(dpm, svc)
+	controller := testController(clientset, funcObj.Namespace, map[string]string{
+		"runtime-images": testRuntimeImages(),
+	})
+	controller.kubelessclientset = kubelessfake.NewSimpleClientset(funcObj)
+
+	err := controller.WaitForFunctionReady(funcObj, 300*time.Millisecond)
+	if err == nil {
+		t.Fatalf("expected a NotReadyError once the timeout elapsed")
+	}
+	if _, ok := err.(*NotReadyError); !ok {
+		t.Fatalf("expected a *NotReadyError, got %T: %v", err, err)
+	}
+}
+
+func TestEnsureK8sResourcesProbeMerging(t *testing.T) {
+	runtimeImages := `[
+		{
+			"ID": "ruby",
+			"depName": "Gemfile",
+			"fileNameSuffix": ".rb",
+			"versions": [
+				{"name": "ruby24", "version": "2.4", "imagePullSecrets":[]}
+			],
+			"livenessProbeInfo": {
+				"httpGet": {"path": "/healthz", "port": 8080},
+				"initialDelaySeconds": 5,
+				"periodSeconds": 10
+			},
+			"readinessProbeInfo": {
+				"httpGet": {"path": "/ready", "port": 8080},
+				"initialDelaySeconds": 3,
+				"periodSeconds": 5
+			}
+		}
+	]`
+
+	t.Run("runtime-only probe", func(t *testing.T) {
+		funcObj := testFunc()
+		clientset := fake.NewSimpleClientset()
+		controller := testController(clientset, funcObj.Namespace, map[string]string{"runtime-images": runtimeImages})
+
+		if err := controller.ensureK8sResources(funcObj); err != nil {
+			t.Fatalf("ensureK8sResources returned err: %v", err)
+		}
+		dpm, _ := clientset.AppsV1().Deployments(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{})
+		probe := dpm.Spec.Template.Spec.Containers[0].LivenessProbe
+		if probe == nil || probe.InitialDelaySeconds != 5 || probe.HTTPGet == nil || probe.HTTPGet.Path != "/healthz" {
+			t.Fatalf("expected the runtime's default liveness probe, got %+v", probe)
+		}
+	})
+
+	t.Run("user probe over runtime default", func(t *testing.T) {
+		funcObj := testFunc()
+		funcObj.Spec.Deployment.Spec.Template.Spec.Containers[0].LivenessProbe = &v1.Probe{
+			Handler:             v1.Handler{Exec: &v1.ExecAction{Command: []string{"true"}}},
+			InitialDelaySeconds: 42,
+		}
+		clientset := fake.NewSimpleClientset()
+		controller := testController(clientset, funcObj.Namespace, map[string]string{"runtime-images": runtimeImages})
+
+		if err := controller.ensureK8sResources(funcObj); err != nil {
+			t.Fatalf("ensureK8sResources returned err: %v", err)
+		}
+		dpm, _ := clientset.AppsV1().Deployments(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{})
+		probe := dpm.Spec.Template.Spec.Containers[0].LivenessProbe
+		if probe == nil || probe.Exec == nil || probe.InitialDelaySeconds != 42 {
+			t.Fatalf("expected the user's own liveness probe handler and InitialDelaySeconds to win, got %+v", probe)
+		}
+		if probe.PeriodSeconds != 10 {
+			t.Fatalf("expected the unset PeriodSeconds to fall back to the runtime default (10), got %d", probe.PeriodSeconds)
+		}
+	})
+
+	t.Run("partial override merges field by field", func(t *testing.T) {
+		funcObj := testFunc()
+		funcObj.Spec.Deployment.Spec.Template.Spec.Containers[0].ReadinessProbe = &v1.Probe{
+			PeriodSeconds: 99,
+		}
+		clientset := fake.NewSimpleClientset()
+		controller := testController(clientset, funcObj.Namespace, map[string]string{"runtime-images": runtimeImages})
+
+		if err := controller.ensureK8sResources(funcObj); err != nil {
+			t.Fatalf("ensureK8sResources returned err: %v", err)
+		}
+		dpm, _ := clientset.AppsV1().Deployments(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{})
+		probe := dpm.Spec.Template.Spec.Containers[0].ReadinessProbe
+		if probe.PeriodSeconds != 99 {
+			t.Fatalf("expected the user's PeriodSeconds override to win, got %d", probe.PeriodSeconds)
+		}
+		if probe.InitialDelaySeconds != 3 {
+			t.Fatalf("expected InitialDelaySeconds to fall back to the runtime default (3), got %d", probe.InitialDelaySeconds)
+		}
+		if probe.HTTPGet == nil || probe.HTTPGet.Path != "/ready" {
+			t.Fatalf("expected the Handler to fall back to the runtime default, got %+v", probe.HTTPGet)
+		}
+	})
+}
+
+func TestEnsureK8sResourcesStartupProbeFeatureGate(t *testing.T) {
+	runtimeImages := `[
+		{
+			"ID": "ruby",
+			"depName": "Gemfile",
+			"fileNameSuffix": ".rb",
+			"versions": [{"name": "ruby24", "version": "2.4", "imagePullSecrets":[]}],
+			"startupProbeInfo": {
+				"httpGet": {"path": "/startup", "port": 8080},
+				"periodSeconds": 2
+			}
+		}
+	]`
+
+	funcObj := testFunc()
+	clientset := fake.NewSimpleClientset()
+	controller := testController(clientset, funcObj.Namespace, map[string]string{"runtime-images": runtimeImages})
+	if err := controller.ensureK8sResources(funcObj); err != nil {
+		t.Fatalf("ensureK8sResources returned err: %v", err)
+	}
+	dpm, _ := clientset.AppsV1().Deployments(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{})
+	if probe := dpm.Spec.Template.Spec.Containers[0].StartupProbe; probe != nil {
+		t.Fatalf("expected the startup probe to stay disabled by default, got %+v", probe)
+	}
+
+	funcObj2 := testFunc()
+	clientset2 := fake.NewSimpleClientset()
+	controller2 := testController(clientset2, funcObj2.Namespace, map[string]string{
+		"runtime-images":           runtimeImages,
+		startupProbeFeatureGateKey: "enabled",
+	})
+	if err := controller2.ensureK8sResources(funcObj2); err != nil {
+		t.Fatalf("ensureK8sResources returned err: %v", err)
+	}
+	dpm2, _ := clientset2.AppsV1().Deployments(funcObj2.Namespace).Get(funcObj2.Name, metav1.GetOptions{})
+	probe := dpm2.Spec.Template.Spec.Containers[0].StartupProbe
+	if probe == nil || probe.HTTPGet == nil || probe.HTTPGet.Path != "/startup" {
+		t.Fatalf("expected the startup probe once the feature gate is enabled, got %+v", probe)
+	}
+}
+
 func testFunc() *kubelessApi.Function {
 	var replicas int32
 	replicas = 10
@@ -335,9 +766,10 @@ func testController(clientset kubernetes.Interface, namespace string, configData
 	lr.ReadConfigMap()
 
 	return &FunctionController{
-		logger:      logrus.WithField("pkg", "controller"),
-		clientset:   clientset,
-		langRuntime: lr,
-		config:      config,
+		logger:            logrus.WithField("pkg", "controller"),
+		clientset:         clientset,
+		kubelessclientset: kubelessfake.NewSimpleClientset(),
+		langRuntime:       lr,
+		config:            config,
 	}
 }
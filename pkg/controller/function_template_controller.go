@@ -0,0 +1,246 @@
+/*
+Copyright (c) 2016-2019 Bitnami
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	kubelessApi "github.com/kubeless/kubeless/pkg/apis/kubeless/v1beta1"
+	kubelessclientset "github.com/kubeless/kubeless/pkg/client/clientset/versioned"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const functionInstanceKind = "FunctionInstance"
+
+// FunctionTemplateController materializes FunctionInstance custom resources
+// into concrete Functions, rendering their referenced FunctionTemplate's
+// Template against the instance's Values. It is independent of
+// FunctionController, which goes on reconciling the Functions this
+// controller produces exactly like any other.
+type FunctionTemplateController struct {
+	logger            *logrus.Entry
+	kubelessclientset kubelessclientset.Interface
+}
+
+// NewFunctionTemplateController returns a FunctionTemplateController wired
+// to the given kubeless clientset.
+func NewFunctionTemplateController(kubelessclientset kubelessclientset.Interface) *FunctionTemplateController {
+	return &FunctionTemplateController{
+		logger:            logrus.WithField("pkg", "controller"),
+		kubelessclientset: kubelessclientset,
+	}
+}
+
+// ReconcileFunctionInstance materializes the FunctionInstance named name
+// into a Function and creates or updates it, rejecting the instance if its
+// Values don't satisfy its FunctionTemplate's declared schema. It records
+// the materialized Function's name on the instance's status.
+func (c *FunctionTemplateController) ReconcileFunctionInstance(namespace, name string) (*kubelessApi.Function, error) {
+	instances := c.kubelessclientset.KubelessV1beta1().FunctionInstances(namespace)
+	instance, err := instances.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read FunctionInstance %s/%s: %v", namespace, name, err)
+	}
+
+	funcObj, err := c.materialize(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	functions := c.kubelessclientset.KubelessV1beta1().Functions(namespace)
+	existing, err := functions.Get(funcObj.Name, metav1.GetOptions{})
+	if k8sErrIsNotFound(err) {
+		funcObj, err = functions.Create(funcObj)
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to read Function %s/%s: %v", namespace, funcObj.Name, err)
+	} else {
+		funcObj.ResourceVersion = existing.ResourceVersion
+		funcObj, err = functions.Update(funcObj)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to materialize Function %s/%s: %v", namespace, funcObj.Name, err)
+	}
+
+	instance.Status.FunctionName = funcObj.Name
+	if _, err := instances.UpdateStatus(instance); err != nil {
+		c.logger.Errorf("unable to update FunctionInstance %s/%s status: %v", namespace, name, err)
+	}
+
+	return funcObj, nil
+}
+
+// DeleteFunctionInstance deletes the FunctionInstance named name along with
+// the Function it materialized. The materialized Function also carries an
+// OwnerReference to the FunctionInstance, so a real API server's garbage
+// collector would remove it on its own; deleting it here too means the same
+// cleanup happens immediately against a fake clientset in tests.
+func (c *FunctionTemplateController) DeleteFunctionInstance(namespace, name string) error {
+	instances := c.kubelessclientset.KubelessV1beta1().FunctionInstances(namespace)
+	instance, err := instances.Get(name, metav1.GetOptions{})
+	if err != nil {
+		if k8sErrIsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to read FunctionInstance %s/%s: %v", namespace, name, err)
+	}
+
+	funcName := instance.Status.FunctionName
+	if funcName == "" {
+		funcName = instance.Name
+	}
+	if err := c.kubelessclientset.KubelessV1beta1().Functions(namespace).Delete(funcName, &metav1.DeleteOptions{}); err != nil && !k8sErrIsNotFound(err) {
+		return fmt.Errorf("unable to delete generated Function %s/%s: %v", namespace, funcName, err)
+	}
+
+	if err := instances.Delete(name, &metav1.DeleteOptions{}); err != nil && !k8sErrIsNotFound(err) {
+		return fmt.Errorf("unable to delete FunctionInstance %s/%s: %v", namespace, name, err)
+	}
+	return nil
+}
+
+// materialize renders instance's FunctionTemplate into a Function, owned by
+// instance so deleting it garbage-collects the Function.
+func (c *FunctionTemplateController) materialize(instance *kubelessApi.FunctionInstance) (*kubelessApi.Function, error) {
+	tmpl, err := c.kubelessclientset.KubelessV1beta1().FunctionTemplates(instance.Namespace).Get(instance.Spec.TemplateName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read FunctionTemplate %s/%s: %v", instance.Namespace, instance.Spec.TemplateName, err)
+	}
+
+	if err := validateValues(tmpl.Spec.ValuesSchema, instance.Spec.Values); err != nil {
+		return nil, fmt.Errorf("FunctionInstance %s/%s violates the %q template's values schema: %v", instance.Namespace, instance.Name, tmpl.Name, err)
+	}
+
+	rendered, err := renderFunctionTemplate(tmpl.Spec.Template, instance.Spec.Values)
+	if err != nil {
+		return nil, fmt.Errorf("unable to render FunctionTemplate %q: %v", tmpl.Name, err)
+	}
+
+	var spec kubelessApi.FunctionSpec
+	decoder := json.NewDecoder(strings.NewReader(rendered))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&spec); err != nil {
+		return nil, fmt.Errorf("unable to parse the rendered %q template: %v", tmpl.Name, err)
+	}
+
+	controller := true
+	blockOwnerDeletion := true
+	return &kubelessApi.Function{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.Name,
+			Namespace: instance.Namespace,
+			Labels:    instance.Labels,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         kubelessApi.SchemeGroupVersion.String(),
+					Kind:               functionInstanceKind,
+					Name:               instance.Name,
+					UID:                instance.UID,
+					Controller:         &controller,
+					BlockOwnerDeletion: &blockOwnerDeletion,
+				},
+			},
+		},
+		Spec: spec,
+	}, nil
+}
+
+// renderFunctionTemplate renders raw (a FunctionSpec marshalled to JSON with
+// {{ .Values.X }} placeholders) as a Go template, reusing the same funcmap
+// the "deployment"/"deployment-template" ConfigMap keys render with.
+func renderFunctionTemplate(raw string, values map[string]interface{}) (string, error) {
+	tmpl, err := template.New("function-template").Funcs(deploymentTemplateFuncs).Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("parsing template: %v", err)
+	}
+
+	data := struct {
+		Values map[string]interface{}
+	}{Values: values}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("executing template: %v", err)
+	}
+	return buf.String(), nil
+}
+
+// validateValues checks values against schema's declared types and required
+// flags, returning a single error describing every violation found.
+func validateValues(schema map[string]kubelessApi.ValueSchema, values map[string]interface{}) error {
+	var missing, mismatched []string
+	for name, vs := range schema {
+		v, ok := values[name]
+		if !ok {
+			if vs.Required {
+				missing = append(missing, name)
+			}
+			continue
+		}
+		if !valueMatchesType(v, vs.Type) {
+			mismatched = append(mismatched, fmt.Sprintf("%s (want %s)", name, vs.Type))
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(mismatched)
+
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, fmt.Sprintf("missing required value(s): %s", strings.Join(missing, ", ")))
+	}
+	if len(mismatched) > 0 {
+		problems = append(problems, fmt.Sprintf("value(s) with the wrong type: %s", strings.Join(mismatched, ", ")))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// valueMatchesType reports whether v, as decoded from JSON, satisfies
+// wantType. Unrecognized types are left unchecked rather than rejected, so a
+// template author isn't blocked by a typo in a schema nobody enforces yet.
+func valueMatchesType(v interface{}, wantType string) bool {
+	switch wantType {
+	case "string":
+		_, ok := v.(string)
+		return ok
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "integer":
+		switch n := v.(type) {
+		case float64:
+			return n == float64(int64(n))
+		case int, int32, int64:
+			return true
+		}
+		return false
+	case "number":
+		switch v.(type) {
+		case float64, int, int32, int64:
+			return true
+		}
+		return false
+	default:
+		return true
+	}
+}
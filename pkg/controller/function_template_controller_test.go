@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2016-2019 Bitnami
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	kubelessApi "github.com/kubeless/kubeless/pkg/apis/kubeless/v1beta1"
+	kubelessfake "github.com/kubeless/kubeless/pkg/client/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testFunctionTemplate() *kubelessApi.FunctionTemplate {
+	return &kubelessApi.FunctionTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "http-handler",
+			Namespace: "default",
+		},
+		Spec: kubelessApi.FunctionTemplateSpec{
+			Template: `{
+				"handler": "{{ .Values.handler }}",
+				"function": "function body",
+				"runtime": "{{ .Values.runtime }}"
+			}`,
+			ValuesSchema: map[string]kubelessApi.ValueSchema{
+				"handler": {Type: "string", Required: true},
+				"runtime": {Type: "string", Required: true},
+			},
+		},
+	}
+}
+
+func testFunctionTemplateController(objects ...interface{}) (*FunctionTemplateController, *kubelessfake.Clientset) {
+	clientset := kubelessfake.NewSimpleClientset()
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *kubelessApi.FunctionTemplate:
+			if _, err := clientset.KubelessV1beta1().FunctionTemplates(o.Namespace).Create(o); err != nil {
+				panic(err)
+			}
+		case *kubelessApi.FunctionInstance:
+			if _, err := clientset.KubelessV1beta1().FunctionInstances(o.Namespace).Create(o); err != nil {
+				panic(err)
+			}
+		}
+	}
+	return NewFunctionTemplateController(clientset), clientset
+}
+
+func TestReconcileFunctionInstanceMaterializesFunction(t *testing.T) {
+	instance := &kubelessApi.FunctionInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-handler", Namespace: "default", UID: "instance-uid"},
+		Spec: kubelessApi.FunctionInstanceSpec{
+			TemplateName: "http-handler",
+			Values: map[string]interface{}{
+				"handler": "foo.bar",
+				"runtime": "python2.7",
+			},
+		},
+	}
+	controller, clientset := testFunctionTemplateController(testFunctionTemplate(), instance)
+
+	funcObj, err := controller.ReconcileFunctionInstance("default", "my-handler")
+	if err != nil {
+		t.Fatalf("ReconcileFunctionInstance returned err: %v", err)
+	}
+	if funcObj.Spec.Handler != "foo.bar" || funcObj.Spec.Runtime != "python2.7" {
+		t.Fatalf("expected the template's placeholders to render from Values, got %+v", funcObj.Spec)
+	}
+	if len(funcObj.OwnerReferences) != 1 || funcObj.OwnerReferences[0].Name != "my-handler" || funcObj.OwnerReferences[0].Kind != functionInstanceKind {
+		t.Fatalf("expected the Function to be owned by its FunctionInstance, got %+v", funcObj.OwnerReferences)
+	}
+
+	updated, err := clientset.KubelessV1beta1().FunctionInstances("default").Get("my-handler", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unable to read back the FunctionInstance: %v", err)
+	}
+	if updated.Status.FunctionName != "my-handler" {
+		t.Fatalf("expected the FunctionInstance's status to record the materialized Function's name, got %q", updated.Status.FunctionName)
+	}
+}
+
+func TestReconcileFunctionInstanceRejectsSchemaViolation(t *testing.T) {
+	instance := &kubelessApi.FunctionInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-handler", Namespace: "default"},
+		Spec: kubelessApi.FunctionInstanceSpec{
+			TemplateName: "http-handler",
+			Values: map[string]interface{}{
+				"handler": "foo.bar",
+				// "runtime" is required by the template's schema but missing.
+			},
+		},
+	}
+	controller, clientset := testFunctionTemplateController(testFunctionTemplate(), instance)
+
+	if _, err := controller.ReconcileFunctionInstance("default", "my-handler"); err == nil {
+		t.Fatal("expected ReconcileFunctionInstance to reject values missing a required field")
+	} else if !strings.Contains(err.Error(), "runtime") {
+		t.Fatalf("expected the error to name the missing value, got: %v", err)
+	}
+
+	if _, err := clientset.KubelessV1beta1().Functions("default").Get("my-handler", metav1.GetOptions{}); err == nil {
+		t.Fatal("expected no Function to be materialized for a schema-violating FunctionInstance")
+	}
+}
+
+func TestDeleteFunctionInstanceGarbageCollectsFunction(t *testing.T) {
+	instance := &kubelessApi.FunctionInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-handler", Namespace: "default"},
+		Spec: kubelessApi.FunctionInstanceSpec{
+			TemplateName: "http-handler",
+			Values: map[string]interface{}{
+				"handler": "foo.bar",
+				"runtime": "python2.7",
+			},
+		},
+	}
+	controller, clientset := testFunctionTemplateController(testFunctionTemplate(), instance)
+
+	if _, err := controller.ReconcileFunctionInstance("default", "my-handler"); err != nil {
+		t.Fatalf("ReconcileFunctionInstance returned err: %v", err)
+	}
+
+	if err := controller.DeleteFunctionInstance("default", "my-handler"); err != nil {
+		t.Fatalf("DeleteFunctionInstance returned err: %v", err)
+	}
+
+	if _, err := clientset.KubelessV1beta1().Functions("default").Get("my-handler", metav1.GetOptions{}); err == nil {
+		t.Fatal("expected the materialized Function to be deleted along with its FunctionInstance")
+	}
+	if _, err := clientset.KubelessV1beta1().FunctionInstances("default").Get("my-handler", metav1.GetOptions{}); err == nil {
+		t.Fatal("expected the FunctionInstance itself to be deleted")
+	}
+}
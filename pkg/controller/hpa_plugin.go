@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2016-2019 Bitnami
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	kubelessApi "github.com/kubeless/kubeless/pkg/apis/kubeless/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// horizontalPodAutoscalerPlugin manages the HPA that scales a Function's
+// Deployment. It is optional: a Function whose HorizontalPodAutoscalerSpec
+// is unset still gets an HPA object, but with no effect until a user sets
+// MinReplicas/MaxReplicas/metrics.
+type horizontalPodAutoscalerPlugin struct {
+	c *FunctionController
+}
+
+func newHorizontalPodAutoscalerPlugin(c *FunctionController) ResourcePlugin {
+	return &horizontalPodAutoscalerPlugin{c: c}
+}
+
+func (p *horizontalPodAutoscalerPlugin) Name() string {
+	return "horizontalpodautoscaler"
+}
+
+func (p *horizontalPodAutoscalerPlugin) Create(ctx context.Context, funcObj *kubelessApi.Function) error {
+	hpa := p.c.getHPASpec(funcObj)
+	hpa.OwnerReferences = p.OwnerRefs(funcObj)
+	_, err := p.c.clientset.AutoscalingV2beta1().HorizontalPodAutoscalers(funcObj.Namespace).Create(hpa)
+	return err
+}
+
+func (p *horizontalPodAutoscalerPlugin) Update(ctx context.Context, funcObj *kubelessApi.Function) error {
+	if _, err := p.c.clientset.AutoscalingV2beta1().HorizontalPodAutoscalers(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{}); err != nil {
+		return err
+	}
+	hpa := p.c.getHPASpec(funcObj)
+	hpa.OwnerReferences = p.OwnerRefs(funcObj)
+	_, err := p.c.clientset.AutoscalingV2beta1().HorizontalPodAutoscalers(funcObj.Namespace).Update(hpa)
+	return err
+}
+
+func (p *horizontalPodAutoscalerPlugin) Delete(ctx context.Context, namespace, name string) error {
+	return p.c.clientset.AutoscalingV2beta1().HorizontalPodAutoscalers(namespace).Delete(name, &metav1.DeleteOptions{})
+}
+
+func (p *horizontalPodAutoscalerPlugin) OwnerRefs(funcObj *kubelessApi.Function) []metav1.OwnerReference {
+	return []metav1.OwnerReference{functionOwnerRef(funcObj)}
+}
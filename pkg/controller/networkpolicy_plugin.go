@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2016-2019 Bitnami
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	kubelessApi "github.com/kubeless/kubeless/pkg/apis/kubeless/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// networkPolicyPluginEnableKey is the kubeless-config key that opts a
+// cluster in to kubeless provisioning a NetworkPolicy per Function. It
+// defaults to disabled: most clusters either don't run a network plugin that
+// enforces NetworkPolicy or manage ingress rules for the kubeless namespace
+// out of band.
+const networkPolicyPluginEnableKey = "network-policy"
+
+// networkPolicyPlugin manages an optional NetworkPolicy restricting ingress
+// to a Function's pods to traffic on the ports its Deployment exposes.
+// Disabled clusters leave Create/Update/Delete as no-ops so the plugin is
+// safe to run everywhere.
+type networkPolicyPlugin struct {
+	c *FunctionController
+}
+
+func newNetworkPolicyPlugin(c *FunctionController) ResourcePlugin {
+	return &networkPolicyPlugin{c: c}
+}
+
+func (p *networkPolicyPlugin) Name() string {
+	return "networkpolicy"
+}
+
+func (p *networkPolicyPlugin) enabled() bool {
+	return p.c.config != nil && p.c.config.Data[networkPolicyPluginEnableKey] == "enabled"
+}
+
+// spec builds the NetworkPolicy for funcObj from the same merged Deployment
+// spec deployment_plugin actually creates (funcObj.Spec.Deployment layered
+// under the "deployment"/"deployment-template" ConfigMap override), so the
+// pod selector and allowed ports match the real pods and real containers
+// rather than the Function's raw, possibly-incomplete declaration. A
+// container that exposes no ports gets no ingress rule at all, which denies
+// all ingress to it rather than allowing everything.
+func (p *networkPolicyPlugin) spec(funcObj *kubelessApi.Function) (*networkingv1.NetworkPolicy, error) {
+	dpm, err := p.c.getDeploymentSpec(funcObj)
+	if err != nil {
+		return nil, err
+	}
+
+	podSelector := dpm.Spec.Template.Labels
+	if podSelector == nil {
+		podSelector = map[string]string{}
+	}
+
+	var ports []networkingv1.NetworkPolicyPort
+	for _, container := range dpm.Spec.Template.Spec.Containers {
+		for _, cp := range container.Ports {
+			protocol := cp.Protocol
+			if protocol == "" {
+				protocol = v1.ProtocolTCP
+			}
+			port := intstr.FromInt(int(cp.ContainerPort))
+			ports = append(ports, networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &port})
+		}
+	}
+
+	var ingress []networkingv1.NetworkPolicyIngressRule
+	if len(ports) > 0 {
+		ingress = []networkingv1.NetworkPolicyIngressRule{{Ports: ports}}
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            funcObj.Name,
+			Namespace:       funcObj.Namespace,
+			OwnerReferences: []metav1.OwnerReference{functionOwnerRef(funcObj)},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: podSelector},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress:     ingress,
+		},
+	}, nil
+}
+
+func (p *networkPolicyPlugin) Create(ctx context.Context, funcObj *kubelessApi.Function) error {
+	if !p.enabled() {
+		return nil
+	}
+	np, err := p.spec(funcObj)
+	if err != nil {
+		return err
+	}
+	np.OwnerReferences = p.OwnerRefs(funcObj)
+	_, err = p.c.clientset.NetworkingV1().NetworkPolicies(funcObj.Namespace).Create(np)
+	return err
+}
+
+func (p *networkPolicyPlugin) Update(ctx context.Context, funcObj *kubelessApi.Function) error {
+	if !p.enabled() {
+		return nil
+	}
+	if _, err := p.c.clientset.NetworkingV1().NetworkPolicies(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{}); err != nil {
+		return err
+	}
+	np, err := p.spec(funcObj)
+	if err != nil {
+		return err
+	}
+	np.OwnerReferences = p.OwnerRefs(funcObj)
+	_, err = p.c.clientset.NetworkingV1().NetworkPolicies(funcObj.Namespace).Update(np)
+	return err
+}
+
+func (p *networkPolicyPlugin) Delete(ctx context.Context, namespace, name string) error {
+	if !p.enabled() {
+		return nil
+	}
+	return p.c.clientset.NetworkingV1().NetworkPolicies(namespace).Delete(name, &metav1.DeleteOptions{})
+}
+
+func (p *networkPolicyPlugin) OwnerRefs(funcObj *kubelessApi.Function) []metav1.OwnerReference {
+	return []metav1.OwnerReference{functionOwnerRef(funcObj)}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2016-2019 Bitnami
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/kubeless/kubeless/pkg/langruntime"
+	v1 "k8s.io/api/core/v1"
+)
+
+// startupProbeFeatureGateKey gates StartupProbe support behind an explicit
+// opt-in in the kubeless-config ConfigMap. StartupProbe only exists on
+// clusters running Kubernetes 1.16+; clusters on an older API server would
+// fail to create the Deployment if kubeless always set it.
+const startupProbeFeatureGateKey = "feature-startup-probe"
+
+func (c *FunctionController) startupProbeEnabled() bool {
+	return c.config != nil && c.config.Data[startupProbeFeatureGateKey] == "enabled"
+}
+
+// applyRuntimeDefaults fills in the Liveness/Readiness/(gated) Startup
+// probes the runtime declares, merging them field-by-field with whatever
+// the Function's own container already set via mergeProbe.
+func (c *FunctionController) applyRuntimeDefaults(ri langruntime.RuntimeInfo, container *v1.Container) {
+	container.LivenessProbe = mergeProbe(ri.LivenessProbeInfo, container.LivenessProbe)
+	container.ReadinessProbe = mergeProbe(ri.ReadinessProbeInfo, container.ReadinessProbe)
+	if c.startupProbeEnabled() {
+		container.StartupProbe = mergeProbe(ri.StartupProbeInfo, container.StartupProbe)
+	}
+}
+
+// mergeProbe combines a runtime's default probe with the Function's own
+// override, field by field: the override's Handler (Exec/HTTPGet/TCPSocket)
+// wins whenever it sets one, but any of InitialDelaySeconds, PeriodSeconds,
+// TimeoutSeconds, FailureThreshold, SuccessThreshold the override left at
+// its zero value falls back to the runtime default, rather than the whole
+// override replacing the default outright.
+func mergeProbe(runtimeDefault, override *v1.Probe) *v1.Probe {
+	if override == nil {
+		if runtimeDefault == nil {
+			return nil
+		}
+		return runtimeDefault.DeepCopy()
+	}
+	if runtimeDefault == nil {
+		return override.DeepCopy()
+	}
+
+	merged := override.DeepCopy()
+	if isZeroHandler(merged.Handler) {
+		merged.Handler = *runtimeDefault.Handler.DeepCopy()
+	}
+	if merged.InitialDelaySeconds == 0 {
+		merged.InitialDelaySeconds = runtimeDefault.InitialDelaySeconds
+	}
+	if merged.PeriodSeconds == 0 {
+		merged.PeriodSeconds = runtimeDefault.PeriodSeconds
+	}
+	if merged.TimeoutSeconds == 0 {
+		merged.TimeoutSeconds = runtimeDefault.TimeoutSeconds
+	}
+	if merged.FailureThreshold == 0 {
+		merged.FailureThreshold = runtimeDefault.FailureThreshold
+	}
+	if merged.SuccessThreshold == 0 {
+		merged.SuccessThreshold = runtimeDefault.SuccessThreshold
+	}
+	return merged
+}
+
+func isZeroHandler(h v1.Handler) bool {
+	return h.Exec == nil && h.HTTPGet == nil && h.TCPSocket == nil
+}
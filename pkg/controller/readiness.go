@@ -0,0 +1,212 @@
+/*
+Copyright (c) 2016-2019 Bitnami
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	kubelessApi "github.com/kubeless/kubeless/pkg/apis/kubeless/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/autoscaling/v2beta1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// waitPollInitialInterval and waitPollMaxInterval bound the backoff
+// WaitForFunctionReady uses between polls, the same shape as Helm's
+// pkg/kube/wait.go: start fast, back off exponentially, cap the interval so
+// a long timeout doesn't turn into a handful of very slow polls.
+const (
+	waitPollInitialInterval = 200 * time.Millisecond
+	waitPollMaxInterval     = 5 * time.Second
+)
+
+// notReadyResource names one resource WaitForFunctionReady found not ready
+// yet, and why.
+type notReadyResource struct {
+	Kind   string
+	Name   string
+	Reason string
+}
+
+// NotReadyError is returned by WaitForFunctionReady when timeout elapses
+// before every backing resource reports ready.
+type NotReadyError struct {
+	Resources []notReadyResource
+}
+
+func (e *NotReadyError) Error() string {
+	parts := make([]string, len(e.Resources))
+	for i, r := range e.Resources {
+		parts[i] = fmt.Sprintf("%s/%s (%s)", r.Kind, r.Name, r.Reason)
+	}
+	return fmt.Sprintf("timed out waiting for resources to become ready: %s", strings.Join(parts, "; "))
+}
+
+// WaitForFunctionReady polls the Deployment, Service/Endpoints and
+// HorizontalPodAutoscaler that ensureK8sResources created for funcObj until
+// all of them report ready or timeout elapses, then records a Ready
+// condition on the Function's status. A nil kubelessclientset (as used by
+// callers that only exercise ensureK8sResources/deleteK8sResources) skips
+// the status patch but still waits and returns the readiness error, if any.
+func (c *FunctionController) WaitForFunctionReady(funcObj *kubelessApi.Function, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	interval := waitPollInitialInterval
+
+	var notReady []notReadyResource
+	for {
+		var err error
+		notReady, err = c.notReadyResources(funcObj)
+		if err != nil {
+			return fmt.Errorf("checking readiness of %s/%s: %v", funcObj.Namespace, funcObj.Name, err)
+		}
+		if len(notReady) == 0 {
+			return c.patchReadyCondition(funcObj, v1.ConditionTrue, "ResourcesReady", "deployment, service and horizontal pod autoscaler are all ready")
+		}
+		if !time.Now().Before(deadline) {
+			break
+		}
+		time.Sleep(interval)
+		interval *= 2
+		if interval > waitPollMaxInterval {
+			interval = waitPollMaxInterval
+		}
+	}
+
+	waitErr := &NotReadyError{Resources: notReady}
+	if err := c.patchReadyCondition(funcObj, v1.ConditionFalse, "Timeout", waitErr.Error()); err != nil {
+		c.logger.Errorf("unable to patch Ready condition for %s/%s: %v", funcObj.Namespace, funcObj.Name, err)
+	}
+	return waitErr
+}
+
+// notReadyResources evaluates every resource ensureK8sResources creates and
+// returns the ones that aren't ready yet. A HorizontalPodAutoscaler that
+// doesn't exist is not considered an error: it's optional.
+func (c *FunctionController) notReadyResources(funcObj *kubelessApi.Function) ([]notReadyResource, error) {
+	var notReady []notReadyResource
+
+	dpm, err := c.clientset.AppsV1().Deployments(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if ok, reason := isDeploymentReady(dpm); !ok {
+		notReady = append(notReady, notReadyResource{Kind: "Deployment", Name: dpm.Name, Reason: reason})
+	}
+
+	svc, err := c.clientset.CoreV1().Services(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if svc.Spec.ClusterIP != v1.ClusterIPNone && svc.Spec.Type != v1.ServiceTypeExternalName {
+		endpoints, err := c.clientset.CoreV1().Endpoints(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if ok, reason := isServiceReady(endpoints); !ok {
+			notReady = append(notReady, notReadyResource{Kind: "Service", Name: svc.Name, Reason: reason})
+		}
+	}
+
+	hpa, err := c.clientset.AutoscalingV2beta1().HorizontalPodAutoscalers(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{})
+	if k8sErrIsNotFound(err) {
+		return notReady, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if ok, reason := isHPAReady(hpa); !ok {
+		notReady = append(notReady, notReadyResource{Kind: "HorizontalPodAutoscaler", Name: hpa.Name, Reason: reason})
+	}
+
+	return notReady, nil
+}
+
+func isDeploymentReady(dpm *appsv1.Deployment) (bool, string) {
+	if dpm.Status.ObservedGeneration < dpm.Generation {
+		return false, "waiting for the deployment controller to observe the latest spec"
+	}
+	var replicas int32 = 1
+	if dpm.Spec.Replicas != nil {
+		replicas = *dpm.Spec.Replicas
+	}
+	if dpm.Status.UpdatedReplicas < replicas {
+		return false, fmt.Sprintf("%d out of %d new replicas updated", dpm.Status.UpdatedReplicas, replicas)
+	}
+	if dpm.Status.AvailableReplicas < replicas {
+		return false, fmt.Sprintf("%d out of %d replicas available", dpm.Status.AvailableReplicas, replicas)
+	}
+	return true, ""
+}
+
+func isServiceReady(endpoints *v1.Endpoints) (bool, string) {
+	for _, subset := range endpoints.Subsets {
+		if len(subset.Addresses) > 0 {
+			return true, ""
+		}
+	}
+	return false, "no ready endpoint addresses"
+}
+
+func isHPAReady(hpa *v2beta1.HorizontalPodAutoscaler) (bool, string) {
+	var minReplicas int32 = 1
+	if hpa.Spec.MinReplicas != nil {
+		minReplicas = *hpa.Spec.MinReplicas
+	}
+	if minReplicas <= 0 {
+		return true, ""
+	}
+	if hpa.Status.CurrentReplicas < 1 {
+		return false, "no current replicas reported yet"
+	}
+	return true, ""
+}
+
+// patchReadyCondition merge-patches funcObj's status subresource with a
+// conditions list holding a fresh Ready condition, rather than replacing the
+// whole status, so other status fields are left as they are. A JSON merge
+// patch replaces a list whole, so any other entries already in
+// status.conditions are replaced as well. It is a
+// no-op when the controller has no kubelessclientset, which lets
+// ensureK8sResources-only tests construct a FunctionController without one.
+func (c *FunctionController) patchReadyCondition(funcObj *kubelessApi.Function, status v1.ConditionStatus, reason, message string) error {
+	if c.kubelessclientset == nil {
+		return nil
+	}
+
+	cond := kubelessApi.FunctionCondition{
+		Type:               kubelessApi.FunctionReady,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": []kubelessApi.FunctionCondition{cond},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.kubelessclientset.KubelessV1beta1().Functions(funcObj.Namespace).Patch(funcObj.Name, types.MergePatchType, patch, "status")
+	return err
+}
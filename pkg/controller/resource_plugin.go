@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2016-2019 Bitnami
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	kubelessApi "github.com/kubeless/kubeless/pkg/apis/kubeless/v1beta1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourcePlugin manages one kind of Kubernetes resource on behalf of a
+// Function. The built-in plugins (deployment_plugin.go, service_plugin.go,
+// configmap_plugin.go, hpa_plugin.go, networkpolicy_plugin.go) cover the
+// resources kubeless has always created; operators can register their own
+// via RegisterResourcePlugin to extend what a Function provisions without
+// touching FunctionController itself.
+type ResourcePlugin interface {
+	// Name identifies the plugin in logs and aggregated errors.
+	Name() string
+	// Create provisions the resource for funcObj. It must be safe to call
+	// against a clean namespace (nothing pre-existing).
+	Create(ctx context.Context, funcObj *kubelessApi.Function) error
+	// Update reconciles an existing resource to match funcObj. It returns a
+	// NotFound error (see k8s.io/apimachinery/pkg/api/errors) if the resource
+	// doesn't exist yet, so callers can fall back to Create.
+	Update(ctx context.Context, funcObj *kubelessApi.Function) error
+	// Delete removes the resource identified by namespace/name. Returning a
+	// NotFound error is not treated as a failure by FunctionController.
+	Delete(ctx context.Context, namespace, name string) error
+	// OwnerRefs returns the OwnerReference(s) this plugin stamps onto the
+	// resource it manages for funcObj.
+	OwnerRefs(funcObj *kubelessApi.Function) []metav1.OwnerReference
+}
+
+// ResourcePluginFactory constructs a ResourcePlugin bound to a specific
+// FunctionController. Plugins are re-created on every
+// ensureK8sResources/deleteK8sResources call so they always see the
+// controller's current clientset and config.
+type ResourcePluginFactory func(c *FunctionController) ResourcePlugin
+
+// resourcePluginFactories holds the registry in registration order.
+// ensureK8sResources walks it forwards; deleteK8sResources walks it
+// backwards so dependent resources are removed first.
+var resourcePluginFactories []ResourcePluginFactory
+
+// RegisterResourcePlugin adds a resource plugin factory to the controller's
+// registry. It is meant to be called from an init() function, the same way
+// the built-in plugins register themselves, so that registration order (and
+// therefore ensure/delete order) is deterministic and fixed at program
+// start-up.
+func RegisterResourcePlugin(factory ResourcePluginFactory) {
+	resourcePluginFactories = append(resourcePluginFactories, factory)
+}
+
+func init() {
+	RegisterResourcePlugin(newDeploymentPlugin)
+	RegisterResourcePlugin(newServicePlugin)
+	RegisterResourcePlugin(newConfigMapPlugin)
+	RegisterResourcePlugin(newHorizontalPodAutoscalerPlugin)
+	RegisterResourcePlugin(newNetworkPolicyPlugin)
+}
+
+// resourcePlugins instantiates every registered plugin against c, in
+// registration order.
+func (c *FunctionController) resourcePlugins() []ResourcePlugin {
+	plugins := make([]ResourcePlugin, len(resourcePluginFactories))
+	for i, factory := range resourcePluginFactories {
+		plugins[i] = factory(c)
+	}
+	return plugins
+}
+
+// k8sErrIsNotFound is a nil-safe wrapper around k8sErrors.IsNotFound so
+// callers don't need to special-case a nil error themselves.
+func k8sErrIsNotFound(err error) bool {
+	return err != nil && k8sErrors.IsNotFound(err)
+}
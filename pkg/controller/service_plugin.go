@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2016-2019 Bitnami
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	kubelessApi "github.com/kubeless/kubeless/pkg/apis/kubeless/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// servicePlugin manages the Service that exposes a Function's Deployment.
+type servicePlugin struct {
+	c *FunctionController
+}
+
+func newServicePlugin(c *FunctionController) ResourcePlugin {
+	return &servicePlugin{c: c}
+}
+
+func (p *servicePlugin) Name() string {
+	return "service"
+}
+
+func (p *servicePlugin) Create(ctx context.Context, funcObj *kubelessApi.Function) error {
+	svc := p.c.getServiceSpec(funcObj)
+	svc.OwnerReferences = p.OwnerRefs(funcObj)
+	_, err := p.c.clientset.CoreV1().Services(funcObj.Namespace).Create(svc)
+	return err
+}
+
+func (p *servicePlugin) Update(ctx context.Context, funcObj *kubelessApi.Function) error {
+	if _, err := p.c.clientset.CoreV1().Services(funcObj.Namespace).Get(funcObj.Name, metav1.GetOptions{}); err != nil {
+		return err
+	}
+	svc := p.c.getServiceSpec(funcObj)
+	svc.OwnerReferences = p.OwnerRefs(funcObj)
+	_, err := p.c.clientset.CoreV1().Services(funcObj.Namespace).Update(svc)
+	return err
+}
+
+func (p *servicePlugin) Delete(ctx context.Context, namespace, name string) error {
+	return p.c.clientset.CoreV1().Services(namespace).Delete(name, &metav1.DeleteOptions{})
+}
+
+func (p *servicePlugin) OwnerRefs(funcObj *kubelessApi.Function) []metav1.OwnerReference {
+	return []metav1.OwnerReference{functionOwnerRef(funcObj)}
+}
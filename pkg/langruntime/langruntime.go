@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2016-2019 Bitnami
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package langruntime resolves the container images and probe defaults for
+// each runtime (e.g. "ruby2.4", "python3.7") that an operator has declared
+// via the "runtime-images" key of the kubeless-config ConfigMap.
+package langruntime
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/ghodss/yaml"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+)
+
+// Image is a single build/runtime image for a given runtime version
+type Image struct {
+	Phase string `json:"phase"`
+	Image string `json:"image"`
+}
+
+// ImageSecret references a Secret holding credentials for pulling an Image
+type ImageSecret struct {
+	ImageSecret string `json:"imageSecret"`
+}
+
+// RuntimeVersion holds the version-specific images of a runtime
+type RuntimeVersion struct {
+	Name             string        `json:"name"`
+	Version          string        `json:"version"`
+	Images           []Image       `json:"images"`
+	ImagePullSecrets []ImageSecret `json:"imagePullSecrets,omitempty"`
+}
+
+// RuntimeInfo describes one supported runtime (e.g. "ruby", "python") and
+// the defaults that apply to every version of it unless a Function overrides
+// them.
+type RuntimeInfo struct {
+	ID                 string           `json:"ID"`
+	DepName            string           `json:"depName"`
+	FileNameSuffix     string           `json:"fileNameSuffix"`
+	Versions           []RuntimeVersion `json:"versions"`
+	LivenessProbeInfo  *v1.Probe        `json:"livenessProbeInfo,omitempty"`
+	ReadinessProbeInfo *v1.Probe        `json:"readinessProbeInfo,omitempty"`
+	StartupProbeInfo   *v1.Probe        `json:"startupProbeInfo,omitempty"`
+}
+
+// Langruntimes resolves RuntimeInfo from the kubeless-config ConfigMap
+type Langruntimes struct {
+	kubelessConfig *v1.ConfigMap
+	RuntimeInfo    []RuntimeInfo
+}
+
+// New returns a Langruntimes bound to the given kubeless-config ConfigMap.
+// Call ReadConfigMap to (re)load the runtime list from it.
+func New(config *v1.ConfigMap) *Langruntimes {
+	return &Langruntimes{
+		kubelessConfig: config,
+	}
+}
+
+// ReadConfigMap parses the "runtime-images" key of the kubeless-config
+// ConfigMap into the list of known runtimes. It is a no-op when the key is
+// absent; there is no built-in runtime list, so the list then stays as it
+// was (empty for a new Langruntimes).
+func (l *Langruntimes) ReadConfigMap() {
+	imagesJSON := l.kubelessConfig.Data["runtime-images"]
+	if imagesJSON == "" {
+		return
+	}
+	var runtimeInfo []RuntimeInfo
+	if err := yaml.Unmarshal([]byte(imagesJSON), &runtimeInfo); err != nil {
+		logrus.Fatalf("Unable to parse runtime-images data: %v", err)
+	}
+	l.RuntimeInfo = runtimeInfo
+}
+
+// splitRuntime splits a Function runtime string such as "ruby2.4" into its
+// runtime ID ("ruby") and version ("2.4").
+func splitRuntime(runtime string) (string, string) {
+	re := regexp.MustCompile(`^([a-zA-Z]+)([0-9.]*)$`)
+	groups := re.FindStringSubmatch(runtime)
+	if len(groups) != 3 {
+		return runtime, ""
+	}
+	return groups[1], groups[2]
+}
+
+// GetRuntimeInfo looks up the RuntimeInfo that backs a Function's
+// Spec.Runtime (e.g. "ruby2.4"), requiring that it lists a matching version.
+func (l *Langruntimes) GetRuntimeInfo(runtime string) (RuntimeInfo, error) {
+	id, version := splitRuntime(runtime)
+	for _, ri := range l.RuntimeInfo {
+		if ri.ID != id {
+			continue
+		}
+		for _, v := range ri.Versions {
+			if v.Version == version {
+				return ri, nil
+			}
+		}
+	}
+	return RuntimeInfo{}, fmt.Errorf("the given runtime %s is not supported", runtime)
+}